@@ -0,0 +1,136 @@
+package gollback
+
+import "sync"
+
+func (p *gollback) AllSettled(fns ...AsyncFunc) []Settled {
+	settled := make([]Settled, len(fns))
+
+	var wg sync.WaitGroup
+	wg.Add(len(fns))
+
+	for i, fn := range fns {
+		go func(index int, f AsyncFunc) {
+			defer wg.Done()
+
+			if !p.acquire() {
+				settled[index] = Settled{Err: p.ctx.Err(), Index: index, State: Rejected}
+				return
+			}
+			defer p.release()
+
+			res, err := p.call(f)
+
+			s := Settled{Value: res, Err: err, Index: index, State: Fulfilled}
+			if err != nil {
+				s.State = Rejected
+			}
+
+			settled[index] = s
+		}(i, fn)
+	}
+
+	wg.Wait()
+	p.cancel()
+
+	return settled
+}
+
+func (p *gollback) Any(fns ...AsyncFunc) (interface{}, error) {
+	out := make(chan *response, 1)
+
+	var wg sync.WaitGroup
+	wg.Add(len(fns))
+
+	errs := make([]error, len(fns))
+
+	for i, fn := range fns {
+		go func(index int, f AsyncFunc) {
+			defer wg.Done()
+
+			if !p.acquire() {
+				errs[index] = p.ctx.Err()
+				return
+			}
+			defer p.release()
+
+			res, err := p.call(f)
+			if err != nil {
+				errs[index] = err
+				return
+			}
+
+			select {
+			case out <- &response{res: res, index: index}:
+				p.cancel()
+			default:
+			}
+		}(i, fn)
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	r, ok := <-out
+	if !ok {
+		return nil, &AggregateError{Errors: errs}
+	}
+
+	return r.res, nil
+}
+
+func (p *gollback) Some(n int, fns ...AsyncFunc) ([]Settled, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+
+	results := make(chan Settled, len(fns))
+
+	var wg sync.WaitGroup
+	wg.Add(len(fns))
+
+	for i, fn := range fns {
+		go func(index int, f AsyncFunc) {
+			defer wg.Done()
+
+			if !p.acquire() {
+				results <- Settled{Err: p.ctx.Err(), Index: index, State: Rejected}
+				return
+			}
+			defer p.release()
+
+			res, err := p.call(f)
+
+			s := Settled{Value: res, Err: err, Index: index, State: Fulfilled}
+			if err != nil {
+				s.State = Rejected
+			}
+
+			results <- s
+		}(i, fn)
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	settled := make([]Settled, 0, n)
+	successes := 0
+
+	for s := range results {
+		settled = append(settled, s)
+
+		if s.State == Fulfilled {
+			successes++
+
+			if successes == n {
+				p.cancel()
+				return settled, nil
+			}
+		}
+	}
+
+	return settled, ErrInsufficientSuccesses
+}
@@ -0,0 +1,141 @@
+package gollback
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAllSettledNeverShortCircuits(t *testing.T) {
+	g := New(context.Background())
+
+	settled := g.AllSettled(
+		func(ctx context.Context) (interface{}, error) {
+			return nil, errors.New("boom")
+		},
+		func(ctx context.Context) (interface{}, error) {
+			return "ok", nil
+		},
+	)
+
+	if settled[0].State != Rejected || settled[0].Index != 0 {
+		t.Fatalf("expected index 0 rejected, got %+v", settled[0])
+	}
+	if settled[1].State != Fulfilled || settled[1].Value != "ok" || settled[1].Index != 1 {
+		t.Fatalf("expected index 1 fulfilled with ok, got %+v", settled[1])
+	}
+}
+
+func TestAnyReturnsFirstSuccess(t *testing.T) {
+	g := New(context.Background())
+
+	res, err := g.Any(
+		func(ctx context.Context) (interface{}, error) {
+			return nil, errors.New("fail")
+		},
+		func(ctx context.Context) (interface{}, error) {
+			return "winner", nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res != "winner" {
+		t.Fatalf("expected winner, got %v", res)
+	}
+}
+
+func TestAnyAggregatesErrorsWhenAllFail(t *testing.T) {
+	g := New(context.Background())
+
+	_, err := g.Any(
+		func(ctx context.Context) (interface{}, error) {
+			return nil, errors.New("fail1")
+		},
+		func(ctx context.Context) (interface{}, error) {
+			return nil, errors.New("fail2")
+		},
+	)
+
+	var agg *AggregateError
+	if !errors.As(err, &agg) {
+		t.Fatalf("expected *AggregateError, got %v", err)
+	}
+	if len(agg.Errors) != 2 {
+		t.Fatalf("expected 2 aggregated errors, got %d", len(agg.Errors))
+	}
+}
+
+func TestAnyCancelsRemainingFuncsOnSuccess(t *testing.T) {
+	g := New(context.Background())
+
+	var cancelledSeen int32
+
+	g.Any(
+		func(ctx context.Context) (interface{}, error) {
+			return "winner", nil
+		},
+		func(ctx context.Context) (interface{}, error) {
+			time.Sleep(20 * time.Millisecond)
+			if ctx.Err() != nil {
+				atomic.StoreInt32(&cancelledSeen, 1)
+			}
+			return nil, ctx.Err()
+		},
+	)
+
+	time.Sleep(40 * time.Millisecond)
+
+	if atomic.LoadInt32(&cancelledSeen) != 1 {
+		t.Fatal("expected the losing func's ctx to be cancelled promptly")
+	}
+}
+
+func TestSomeWaitsForNSuccesses(t *testing.T) {
+	g := New(context.Background())
+
+	settled, err := g.Some(2,
+		func(ctx context.Context) (interface{}, error) {
+			return 1, nil
+		},
+		func(ctx context.Context) (interface{}, error) {
+			time.Sleep(5 * time.Millisecond)
+			return 2, nil
+		},
+		func(ctx context.Context) (interface{}, error) {
+			time.Sleep(200 * time.Millisecond)
+			return 3, nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	fulfilled := 0
+	for _, s := range settled {
+		if s.State == Fulfilled {
+			fulfilled++
+		}
+	}
+	if fulfilled < 2 {
+		t.Fatalf("expected at least 2 fulfilled settlements, got %+v", settled)
+	}
+}
+
+func TestSomeReturnsErrInsufficientSuccesses(t *testing.T) {
+	g := New(context.Background())
+
+	_, err := g.Some(2,
+		func(ctx context.Context) (interface{}, error) {
+			return 1, nil
+		},
+		func(ctx context.Context) (interface{}, error) {
+			return nil, errors.New("fail")
+		},
+	)
+	if !errors.Is(err, ErrInsufficientSuccesses) {
+		t.Fatalf("expected ErrInsufficientSuccesses, got %v", err)
+	}
+}
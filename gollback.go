@@ -2,6 +2,8 @@ package gollback
 
 import (
 	"context"
+	"fmt"
+	"runtime"
 	"sync"
 )
 
@@ -16,12 +18,38 @@ type Gollback interface {
 	// All method returns when all of the callbacks passed as an iterable have finished,
 	// returned responses and errors are ordered according to callback order
 	All(fns ...AsyncFunc) ([]interface{}, []error)
+	// AllSettled method returns when all of the callbacks passed as an iterable have finished,
+	// never short-circuiting on an error; each callback's outcome is reported as a Settled value,
+	// ordered according to callback order
+	AllSettled(fns ...AsyncFunc) []Settled
+	// Any method returns as soon as one of the callbacks resolves with a value that is not an error,
+	// otherwise an *AggregateError wrapping every callback's error is returned
+	Any(fns ...AsyncFunc) (interface{}, error)
+	// Some method returns as soon as n of the callbacks have resolved with a value that is not an error,
+	// cancelling the remaining callbacks; if fewer than n succeed, ErrInsufficientSuccesses is returned
+	// alongside whatever Settled values were gathered
+	Some(n int, fns ...AsyncFunc) ([]Settled, error)
+	// AllContext behaves like All, except that once shutdownCtx is done it waits no longer: any callback
+	// that has not yet finished is abandoned, its slot is filled with ErrAbandoned, and AllContext returns
+	// immediately instead of blocking on a misbehaving AsyncFunc that ignores ctx.Done()
+	AllContext(shutdownCtx context.Context, fns ...AsyncFunc) ([]interface{}, []error)
 }
 
 type gollback struct {
-	gollbacks []AsyncFunc
-	ctx       context.Context
-	cancel    context.CancelFunc
+	gollbacks    []AsyncFunc
+	ctx          context.Context
+	cancel       context.CancelFunc
+	sem          chan struct{}
+	panicHandler PanicHandler
+}
+
+// PanicHandler converts a recovered panic value and its stack trace into an
+// error, so a panicking AsyncFunc is reported as a failure instead of
+// crashing the whole program.
+type PanicHandler func(recovered interface{}, stack []byte) error
+
+func defaultPanicHandler(recovered interface{}, stack []byte) error {
+	return fmt.Errorf("gollback: recovered from panic: %v\n%s", recovered, stack)
 }
 
 type response struct {
@@ -30,18 +58,67 @@ type response struct {
 	index int
 }
 
+// acquire blocks until a concurrency slot is available, returning false if ctx
+// was cancelled while waiting. When no limit was configured, it is a no-op.
+func (p *gollback) acquire() bool {
+	if p.sem == nil {
+		return true
+	}
+
+	select {
+	case p.sem <- struct{}{}:
+		return true
+	case <-p.ctx.Done():
+		return false
+	}
+}
+
+func (p *gollback) release() {
+	if p.sem == nil {
+		return
+	}
+
+	<-p.sem
+}
+
+// call invokes f, recovering any panic and converting it to an error via
+// p.panicHandler so a single bad AsyncFunc cannot take down the whole
+// program or leave the other goroutines hanging.
+func (p *gollback) call(f AsyncFunc) (res interface{}, err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			stack := make([]byte, 64<<10)
+			stack = stack[:runtime.Stack(stack, false)]
+
+			handler := p.panicHandler
+			if handler == nil {
+				handler = defaultPanicHandler
+			}
+
+			err = handler(rec, stack)
+		}
+	}()
+
+	return f(p.ctx)
+}
+
 func (p *gollback) Race(fns ...AsyncFunc) (interface{}, error) {
 	out := make(chan *response, 1)
 
 	for i, fn := range fns {
 		go func(index int, f AsyncFunc) {
+			if !p.acquire() {
+				return
+			}
+			defer p.release()
+
 			for {
 				select {
 				case <-p.ctx.Done():
 					return
 				default:
 					var r response
-					r.res, r.err = f(p.ctx)
+					r.res, r.err = p.call(f)
 
 					if p.ctx.Err() != nil {
 						return
@@ -73,13 +150,18 @@ func (p *gollback) All(fns ...AsyncFunc) ([]interface{}, []error) {
 		go func(index int, f AsyncFunc) {
 			defer wg.Done()
 
+			if !p.acquire() {
+				return
+			}
+			defer p.release()
+
 			for {
 				select {
 				case <-p.ctx.Done():
 					return
 				default:
 					var r response
-					r.res, r.err = f(p.ctx)
+					r.res, r.err = p.call(f)
 
 					if p.ctx.Err() != nil {
 						return
@@ -101,15 +183,22 @@ func (p *gollback) All(fns ...AsyncFunc) ([]interface{}, []error) {
 }
 
 // New creates new gollback
-func New(ctx context.Context) Gollback {
+func New(ctx context.Context, opts ...Option) Gollback {
 	if ctx == nil {
 		ctx = context.Background()
 	}
 
 	ctxWithCancel, cancel := context.WithCancel(ctx)
 
-	return &gollback{
-		ctx:    ctxWithCancel,
-		cancel: cancel,
+	p := &gollback{
+		ctx:          ctxWithCancel,
+		cancel:       cancel,
+		panicHandler: defaultPanicHandler,
 	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
 }
@@ -0,0 +1,38 @@
+package gollback
+
+// Option configures a Gollback instance created via New.
+type Option func(p *gollback)
+
+// WithConcurrency caps the number of AsyncFuncs that Race and All will run at
+// the same time to n, using a buffered channel as a semaphore. Every AsyncFunc
+// still gets its own goroutine, which waits for a free slot before calling
+// the func; the option bounds how many funcs run at once, not how many
+// goroutines are started. A value of n <= 0 is treated as unlimited, which is also the
+// default when WithConcurrency is not supplied.
+func WithConcurrency(n int) Option {
+	return func(p *gollback) {
+		if n <= 0 {
+			p.sem = nil
+			return
+		}
+
+		p.sem = make(chan struct{}, n)
+	}
+}
+
+// WithPanicHandler overrides how a panic recovered from an AsyncFunc is
+// converted into an error. By default, the recovered value and a stack trace
+// captured via runtime.Stack are formatted into an error message. A nil
+// handler falls back to that default rather than being stored as-is, since
+// calling a nil PanicHandler from inside the recover path would itself panic
+// unrecoverably.
+func WithPanicHandler(handler PanicHandler) Option {
+	return func(p *gollback) {
+		if handler == nil {
+			p.panicHandler = defaultPanicHandler
+			return
+		}
+
+		p.panicHandler = handler
+	}
+}
@@ -0,0 +1,108 @@
+package gollback
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithConcurrencyCapsAll(t *testing.T) {
+	const limit = 3
+	const total = 20
+
+	var running, maxRunning int32
+
+	fns := make([]AsyncFunc, total)
+	for i := range fns {
+		fns[i] = func(ctx context.Context) (interface{}, error) {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				cur := atomic.LoadInt32(&maxRunning)
+				if n <= cur || atomic.CompareAndSwapInt32(&maxRunning, cur, n) {
+					break
+				}
+			}
+
+			time.Sleep(10 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+
+			return nil, nil
+		}
+	}
+
+	g := New(context.Background(), WithConcurrency(limit))
+	g.All(fns...)
+
+	if got := atomic.LoadInt32(&maxRunning); got > limit {
+		t.Fatalf("expected at most %d funcs running at once, got %d", limit, got)
+	}
+}
+
+func TestWithConcurrencyCapsRace(t *testing.T) {
+	const limit = 2
+	const total = 10
+
+	var running, maxRunning int32
+
+	fns := make([]AsyncFunc, total)
+	for i := range fns {
+		i := i
+		fns[i] = func(ctx context.Context) (interface{}, error) {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				cur := atomic.LoadInt32(&maxRunning)
+				if n <= cur || atomic.CompareAndSwapInt32(&maxRunning, cur, n) {
+					break
+				}
+			}
+
+			time.Sleep(10 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+
+			if i == total-1 {
+				return "winner", nil
+			}
+
+			return nil, nil
+		}
+	}
+
+	g := New(context.Background(), WithConcurrency(limit))
+	if _, err := g.Race(fns...); err != nil {
+		t.Fatalf("unexpected error from Race: %v", err)
+	}
+
+	if got := atomic.LoadInt32(&maxRunning); got > limit {
+		t.Fatalf("expected at most %d funcs running at once, got %d", limit, got)
+	}
+}
+
+func TestWithConcurrencyDrainsOnCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	g := New(ctx, WithConcurrency(1))
+
+	fns := make([]AsyncFunc, 5)
+	for i := range fns {
+		fns[i] = func(ctx context.Context) (interface{}, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		g.All(fns...)
+		close(done)
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("All did not drain cleanly after cancellation")
+	}
+}
@@ -0,0 +1,74 @@
+package gollback
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestAllRecoversPanicAndFinishesOtherFuncs(t *testing.T) {
+	g := New(context.Background())
+
+	rs, errs := g.All(
+		func(ctx context.Context) (interface{}, error) {
+			panic("boom")
+		},
+		func(ctx context.Context) (interface{}, error) {
+			return "fine", nil
+		},
+	)
+
+	if errs[0] == nil {
+		t.Fatal("expected panicking func to be reported as an error")
+	}
+	if errs[1] != nil || rs[1] != "fine" {
+		t.Fatalf("expected the other func to finish normally, got rs=%v errs=%v", rs, errs)
+	}
+}
+
+func TestRaceRecoversPanicAndStillReturnsWinner(t *testing.T) {
+	g := New(context.Background())
+
+	res, err := g.Race(
+		func(ctx context.Context) (interface{}, error) {
+			panic("boom")
+		},
+		func(ctx context.Context) (interface{}, error) {
+			return "winner", nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res != "winner" {
+		t.Fatalf("expected winner, got %v", res)
+	}
+}
+
+func TestWithPanicHandlerCustomConversion(t *testing.T) {
+	sentinel := errors.New("custom panic error")
+
+	g := New(context.Background(), WithPanicHandler(func(recovered interface{}, stack []byte) error {
+		return sentinel
+	}))
+
+	_, errs := g.All(func(ctx context.Context) (interface{}, error) {
+		panic("boom")
+	})
+
+	if !errors.Is(errs[0], sentinel) {
+		t.Fatalf("expected custom handler's error, got %v", errs[0])
+	}
+}
+
+func TestWithPanicHandlerNilFallsBackToDefault(t *testing.T) {
+	g := New(context.Background(), WithPanicHandler(nil))
+
+	_, errs := g.All(func(ctx context.Context) (interface{}, error) {
+		panic("boom")
+	})
+
+	if errs[0] == nil {
+		t.Fatal("expected nil handler to fall back to the default instead of panicking")
+	}
+}
@@ -0,0 +1,108 @@
+package gollback
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+)
+
+// ErrNoAttempts is returned by Retry (and Retryable) when called with
+// attempts <= 0, instead of silently reporting success without ever having
+// called fn.
+var ErrNoAttempts = errors.New("gollback: attempts must be >= 1")
+
+// BackoffFunc returns the delay to wait before the given retry attempt
+// (0-indexed) is made.
+type BackoffFunc func(attempt int) time.Duration
+
+// ConstantBackoff returns a BackoffFunc that always waits d between attempts.
+func ConstantBackoff(d time.Duration) BackoffFunc {
+	return func(attempt int) time.Duration {
+		return d
+	}
+}
+
+// ExponentialBackoff returns a BackoffFunc that doubles base on every attempt,
+// capped at max.
+func ExponentialBackoff(base, max time.Duration) BackoffFunc {
+	return func(attempt int) time.Duration {
+		d := base << uint(attempt)
+
+		if d <= 0 || d > max {
+			return max
+		}
+
+		return d
+	}
+}
+
+// Jitter wraps a BackoffFunc and randomizes its result, spreading out retries
+// that would otherwise fire at the same time (e.g. after a shared outage).
+// The returned delay is chosen uniformly from [0, backoff(attempt)).
+func Jitter(backoff BackoffFunc) BackoffFunc {
+	return func(attempt int) time.Duration {
+		d := backoff(attempt)
+		if d <= 0 {
+			return 0
+		}
+
+		return time.Duration(rand.Int63n(int64(d)))
+	}
+}
+
+// Retry calls fn until it succeeds, attempts is exhausted, or ctx is
+// cancelled, sleeping for backoff(attempt) between tries. It returns the
+// successful result, the last error encountered if attempts run out, or
+// ctx.Err() if ctx is cancelled first. attempts must be at least 1; otherwise Retry
+// returns ErrNoAttempts without calling fn.
+func Retry(ctx context.Context, attempts int, backoff BackoffFunc, fn AsyncFunc) (interface{}, error) {
+	if attempts <= 0 {
+		return nil, ErrNoAttempts
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var lastRes interface{}
+	var lastErr error
+
+	for attempt := 0; attempt < attempts; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return lastRes, err
+		}
+
+		lastRes, lastErr = fn(ctx)
+		if lastErr == nil {
+			return lastRes, nil
+		}
+
+		if attempt == attempts-1 {
+			break
+		}
+
+		if backoff == nil {
+			continue
+		}
+
+		timer := time.NewTimer(backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return lastRes, ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	return lastRes, lastErr
+}
+
+// Retryable adapts fn into an AsyncFunc that retries itself up to attempts
+// times with the given backoff, so it can be composed inside Race, All, and
+// the other Gollback combinators.
+func Retryable(fn AsyncFunc, attempts int, backoff BackoffFunc) AsyncFunc {
+	return func(ctx context.Context) (interface{}, error) {
+		return Retry(ctx, attempts, backoff, fn)
+	}
+}
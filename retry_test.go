@@ -0,0 +1,128 @@
+package gollback
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRetrySucceedsAfterAttempts(t *testing.T) {
+	attempts := 0
+
+	res, err := Retry(context.Background(), 3, ConstantBackoff(time.Millisecond), func(ctx context.Context) (interface{}, error) {
+		attempts++
+		if attempts < 3 {
+			return nil, errors.New("not yet")
+		}
+
+		return "ok", nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res != "ok" {
+		t.Fatalf("expected ok, got %v", res)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryReturnsLastErrorOnExhaustion(t *testing.T) {
+	wantErr := errors.New("still failing")
+
+	_, err := Retry(context.Background(), 2, ConstantBackoff(time.Millisecond), func(ctx context.Context) (interface{}, error) {
+		return nil, wantErr
+	})
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("expected %v, got %v", wantErr, err)
+	}
+}
+
+func TestRetryZeroAttemptsReturnsErrNoAttempts(t *testing.T) {
+	called := false
+
+	_, err := Retry(context.Background(), 0, nil, func(ctx context.Context) (interface{}, error) {
+		called = true
+		return "ok", nil
+	})
+	if called {
+		t.Fatal("fn must not be called when attempts <= 0")
+	}
+	if !errors.Is(err, ErrNoAttempts) {
+		t.Fatalf("expected ErrNoAttempts, got %v", err)
+	}
+}
+
+func TestRetryAbortsOnContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	attempts := 0
+
+	done := make(chan struct{})
+	go func() {
+		Retry(ctx, 100, ConstantBackoff(50*time.Millisecond), func(ctx context.Context) (interface{}, error) {
+			attempts++
+			return nil, errors.New("always fails")
+		})
+		close(done)
+	}()
+
+	time.Sleep(5 * time.Millisecond)
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Retry did not abort promptly after ctx cancellation")
+	}
+
+	if attempts >= 100 {
+		t.Fatalf("expected cancellation to cut retries short, got %d attempts", attempts)
+	}
+}
+
+func TestExponentialBackoffCapsAtMax(t *testing.T) {
+	backoff := ExponentialBackoff(time.Millisecond, 10*time.Millisecond)
+
+	if got := backoff(0); got != time.Millisecond {
+		t.Fatalf("attempt 0: expected %v, got %v", time.Millisecond, got)
+	}
+	if got := backoff(10); got != 10*time.Millisecond {
+		t.Fatalf("attempt 10: expected capped at max, got %v", got)
+	}
+}
+
+func TestJitterStaysWithinBackoffBound(t *testing.T) {
+	backoff := Jitter(ConstantBackoff(10 * time.Millisecond))
+
+	for i := 0; i < 50; i++ {
+		if d := backoff(i); d < 0 || d >= 10*time.Millisecond {
+			t.Fatalf("jittered delay %v out of [0, 10ms) bound", d)
+		}
+	}
+}
+
+func TestRetryableComposesInsideAll(t *testing.T) {
+	attempts := 0
+
+	flaky := Retryable(func(ctx context.Context) (interface{}, error) {
+		attempts++
+		if attempts < 2 {
+			return nil, errors.New("not yet")
+		}
+
+		return "recovered", nil
+	}, 3, ConstantBackoff(time.Millisecond))
+
+	g := New(context.Background())
+	rs, errs := g.All(flaky)
+
+	if errs[0] != nil {
+		t.Fatalf("expected Retryable to recover, got error %v", errs[0])
+	}
+	if rs[0] != "recovered" {
+		t.Fatalf("expected recovered, got %v", rs[0])
+	}
+}
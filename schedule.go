@@ -0,0 +1,234 @@
+package gollback
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+)
+
+// scheduledTask is a single pending item in a Scheduler's min-heap, ordered by
+// fireAt.
+type scheduledTask struct {
+	fireAt time.Time
+	fn     AsyncFunc
+	index  int
+}
+
+// taskHeap implements container/heap.Interface, keeping the soonest-firing
+// scheduledTask at the root.
+type taskHeap []*scheduledTask
+
+func (h taskHeap) Len() int            { return len(h) }
+func (h taskHeap) Less(i, j int) bool  { return h[i].fireAt.Before(h[j].fireAt) }
+func (h taskHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *taskHeap) Push(x interface{}) { *h = append(*h, x.(*scheduledTask)) }
+
+func (h *taskHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	task := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return task
+}
+
+// Scheduler runs AsyncFuncs at a future time, dispatching due tasks through
+// the same concurrency-limited worker pool as All. Construct one with
+// NewScheduler.
+//
+// Known limitation: when NewScheduler is given WithConcurrency, all tasks
+// ever scheduled on it share that one semaphore with no per-task timeout. An
+// AsyncFunc that ignores ctx and blocks forever holds its slot forever, so it
+// can permanently starve every other task scheduled on the same Scheduler.
+// Giving fn its own deadline via context.WithTimeout inside the func body is
+// the caller's responsibility; Retry does not help here, since it waits for
+// each call of fn to return.
+type Scheduler struct {
+	p *gollback
+
+	mu      sync.Mutex
+	heap    taskHeap
+	next    int
+	rs      []interface{}
+	errs    []error
+	wake    chan struct{}
+	stopped bool
+
+	wg sync.WaitGroup
+}
+
+// NewScheduler creates a Scheduler bound to ctx; cancelling ctx (or its
+// parent) abandons any task that has not yet fired, recording ErrAbandoned
+// for it. Options are the same ones accepted by New, so e.g. WithConcurrency
+// limits how many due tasks run at once.
+func NewScheduler(ctx context.Context, opts ...Option) *Scheduler {
+	p := New(ctx, opts...).(*gollback)
+
+	s := &Scheduler{
+		p:    p,
+		wake: make(chan struct{}, 1),
+	}
+
+	go s.run()
+
+	return s
+}
+
+// After schedules fn to run once d has elapsed.
+func (s *Scheduler) After(d time.Duration, fn AsyncFunc) {
+	s.At(time.Now().Add(d), fn)
+}
+
+// At schedules fn to run at t. If t has already passed, fn is dispatched on
+// the next timer tick. If the Scheduler's ctx has already been cancelled
+// (including by a prior Wait returning), fn is never run and its slot is
+// recorded as ErrAbandoned instead; fireDue rechecks ctx under the same lock
+// before dispatching, so this holds even for a task that snuck onto the heap
+// in the instant before run notices the cancellation.
+func (s *Scheduler) At(t time.Time, fn AsyncFunc) {
+	s.mu.Lock()
+
+	index := s.next
+	s.next++
+	s.rs = append(s.rs, nil)
+
+	if s.stopped {
+		s.errs = append(s.errs, ErrAbandoned)
+		s.mu.Unlock()
+		return
+	}
+
+	s.errs = append(s.errs, nil)
+	heap.Push(&s.heap, &scheduledTask{fireAt: t, fn: fn, index: index})
+	s.wg.Add(1)
+	s.mu.Unlock()
+
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Wait blocks until every scheduled task has fired and finished (or been
+// abandoned because ctx was cancelled), returning results and errors ordered
+// by the sequence tasks were scheduled in.
+func (s *Scheduler) Wait() ([]interface{}, []error) {
+	s.wg.Wait()
+	s.p.cancel()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.rs, s.errs
+}
+
+// run is the single timer goroutine: it sleeps until the soonest-due task's
+// fire time, dispatches everything due, and repeats, until ctx is cancelled.
+func (s *Scheduler) run() {
+	for {
+		if s.p.ctx.Err() != nil {
+			s.shutdown()
+			return
+		}
+
+		s.mu.Lock()
+		hasNext := len(s.heap) > 0
+		var d time.Duration
+		if hasNext {
+			d = time.Until(s.heap[0].fireAt)
+		}
+		s.mu.Unlock()
+
+		if !hasNext {
+			select {
+			case <-s.p.ctx.Done():
+				s.shutdown()
+				return
+			case <-s.wake:
+			}
+			continue
+		}
+
+		if d <= 0 {
+			s.fireDue()
+			continue
+		}
+
+		timer := time.NewTimer(d)
+		select {
+		case <-s.p.ctx.Done():
+			timer.Stop()
+			s.shutdown()
+			return
+		case <-s.wake:
+			timer.Stop()
+		case <-timer.C:
+			s.fireDue()
+		}
+	}
+}
+
+// fireDue pops every task whose fire time has arrived and dispatches each
+// through the shared worker pool. It rechecks ctx under the same lock At uses
+// to admit tasks, so a task that only slipped onto the heap in the narrow
+// window before run notices ctx is done gets abandoned here instead of
+// dispatched.
+func (s *Scheduler) fireDue() {
+	now := time.Now()
+
+	var due []*scheduledTask
+
+	s.mu.Lock()
+	if s.p.ctx.Err() != nil {
+		s.mu.Unlock()
+		s.shutdown()
+		return
+	}
+	for len(s.heap) > 0 && !s.heap[0].fireAt.After(now) {
+		due = append(due, heap.Pop(&s.heap).(*scheduledTask))
+	}
+	s.mu.Unlock()
+
+	for _, task := range due {
+		go s.dispatch(task)
+	}
+}
+
+func (s *Scheduler) dispatch(task *scheduledTask) {
+	defer s.wg.Done()
+
+	if !s.p.acquire() {
+		s.mu.Lock()
+		s.errs[task.index] = s.p.ctx.Err()
+		s.mu.Unlock()
+		return
+	}
+	defer s.p.release()
+
+	res, err := s.p.call(task.fn)
+
+	s.mu.Lock()
+	s.rs[task.index] = res
+	s.errs[task.index] = err
+	s.mu.Unlock()
+}
+
+// shutdown marks the Scheduler stopped and abandons every task still waiting
+// in the heap, used once ctx is cancelled so Wait does not block forever on
+// tasks that will never fire. Once stopped is set, At/After stop admitting
+// new tasks into the heap (recording ErrAbandoned immediately instead),
+// which is what lets run exit for good here rather than having to keep
+// polling a done ctx.
+func (s *Scheduler) shutdown() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stopped = true
+
+	for len(s.heap) > 0 {
+		task := heap.Pop(&s.heap).(*scheduledTask)
+		s.errs[task.index] = ErrAbandoned
+		s.wg.Done()
+	}
+}
@@ -0,0 +1,143 @@
+package gollback
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSchedulerFiresInFireTimeOrder(t *testing.T) {
+	sched := NewScheduler(context.Background())
+
+	var mu sync.Mutex
+	var order []int
+
+	record := func(v int) {
+		mu.Lock()
+		order = append(order, v)
+		mu.Unlock()
+	}
+
+	sched.After(20*time.Millisecond, func(ctx context.Context) (interface{}, error) {
+		record(2)
+		return 2, nil
+	})
+	sched.After(5*time.Millisecond, func(ctx context.Context) (interface{}, error) {
+		record(1)
+		return 1, nil
+	})
+
+	rs, errs := sched.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(order) != 2 || order[0] != 1 || order[1] != 2 {
+		t.Fatalf("expected fire order [1 2], got %v (results %v)", order, rs)
+	}
+}
+
+func TestSchedulerDrainsPendingTasksOnCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	sched := NewScheduler(ctx)
+
+	sched.After(time.Hour, func(ctx context.Context) (interface{}, error) {
+		t.Fatal("this task should never fire")
+		return nil, nil
+	})
+
+	cancel()
+
+	_, errs := sched.Wait()
+	if len(errs) != 1 || !errors.Is(errs[0], ErrAbandoned) {
+		t.Fatalf("expected [ErrAbandoned], got %v", errs)
+	}
+}
+
+func TestSchedulerSecondWaitDoesNotHangAfterFirstWaitShutsDown(t *testing.T) {
+	sched := NewScheduler(context.Background())
+
+	sched.After(time.Millisecond, func(ctx context.Context) (interface{}, error) {
+		return 1, nil
+	})
+	sched.Wait()
+
+	sched.After(time.Millisecond, func(ctx context.Context) (interface{}, error) {
+		return 2, nil
+	})
+
+	done := make(chan struct{})
+	go func() {
+		sched.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("second Wait hung after the Scheduler had already shut down")
+	}
+}
+
+func TestSchedulerAfterShutdownRecordsErrAbandonedWithoutRunning(t *testing.T) {
+	sched := NewScheduler(context.Background())
+	sched.Wait()
+
+	// No sleep needed here: even if At races shutdown's stopped flag and
+	// gets the task onto the heap, fireDue rechecks ctx under the same lock
+	// before dispatching and abandons it there instead.
+	called := false
+	sched.After(0, func(ctx context.Context) (interface{}, error) {
+		called = true
+		return nil, nil
+	})
+
+	rs, errs := sched.Wait()
+	if called {
+		t.Fatal("task scheduled after shutdown must not run")
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], ErrAbandoned) {
+		t.Fatalf("expected [ErrAbandoned], got %v (rs=%v)", errs, rs)
+	}
+}
+
+// TestSchedulerConcurrencyLimitCanStarveOtherTasks documents the known
+// limitation noted on Scheduler: a single AsyncFunc that ignores ctx and
+// blocks forever holds its WithConcurrency slot forever, starving every
+// other task scheduled on the same Scheduler. The second task is only
+// scheduled once the first has provably acquired the sole concurrency slot
+// (it signals that via started, from inside its AsyncFunc, which dispatch
+// only calls after a successful acquire), so this doesn't depend on both
+// tasks landing in the same fireDue batch or on which dispatch goroutine
+// wins a semaphore race.
+func TestSchedulerConcurrencyLimitCanStarveOtherTasks(t *testing.T) {
+	sched := NewScheduler(context.Background(), WithConcurrency(1))
+
+	started := make(chan struct{})
+	sched.After(0, func(ctx context.Context) (interface{}, error) {
+		close(started)
+		select {}
+	})
+
+	<-started
+
+	ran := make(chan struct{})
+	sched.After(0, func(ctx context.Context) (interface{}, error) {
+		close(ran)
+		return nil, nil
+	})
+
+	select {
+	case <-ran:
+		t.Fatal("expected the second task to be starved by the blocked first task")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -0,0 +1,45 @@
+package gollback
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// SettledState describes how an AsyncFunc wrapped by AllSettled resolved.
+type SettledState int
+
+const (
+	// Fulfilled means the AsyncFunc returned without an error.
+	Fulfilled SettledState = iota
+	// Rejected means the AsyncFunc returned an error.
+	Rejected
+)
+
+// Settled holds the outcome of a single AsyncFunc passed to AllSettled or
+// Some, mirroring the shape of a settled JS promise.
+type Settled struct {
+	Value interface{}
+	Err   error
+	Index int
+	State SettledState
+}
+
+// AggregateError collects the errors of every AsyncFunc that failed when
+// none of them succeeded, as returned by Any.
+type AggregateError struct {
+	Errors []error
+}
+
+func (e *AggregateError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+
+	return fmt.Sprintf("gollback: all %d funcs failed: [%s]", len(e.Errors), strings.Join(msgs, "; "))
+}
+
+// ErrInsufficientSuccesses is returned by Some when ctx is cancelled or every
+// AsyncFunc has finished before n of them succeeded.
+var ErrInsufficientSuccesses = errors.New("gollback: fewer than n funcs succeeded")
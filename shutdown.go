@@ -0,0 +1,72 @@
+package gollback
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrAbandoned is the error recorded for any AsyncFunc passed to AllContext
+// that was still running when shutdownCtx fired.
+var ErrAbandoned = errors.New("gollback: func abandoned, shutdown deadline reached before it returned")
+
+func (p *gollback) AllContext(shutdownCtx context.Context, fns ...AsyncFunc) ([]interface{}, []error) {
+	rs := make([]interface{}, len(fns))
+	errs := make([]error, len(fns))
+	claimed := make([]int32, len(fns))
+
+	claim := func(index int) bool {
+		return atomic.CompareAndSwapInt32(&claimed[index], 0, 1)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(len(fns))
+
+	for i, fn := range fns {
+		go func(index int, f AsyncFunc) {
+			if !p.acquire() {
+				if claim(index) {
+					errs[index] = p.ctx.Err()
+					wg.Done()
+				}
+				return
+			}
+			defer p.release()
+
+			res, err := p.call(f)
+
+			if claim(index) {
+				if p.ctx.Err() == nil {
+					rs[index] = res
+					errs[index] = err
+				} else {
+					errs[index] = p.ctx.Err()
+				}
+				wg.Done()
+			}
+		}(i, fn)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-shutdownCtx.Done():
+		for i := range fns {
+			if claim(i) {
+				errs[i] = ErrAbandoned
+				wg.Done()
+			}
+		}
+		<-done
+	}
+
+	p.cancel()
+
+	return rs, errs
+}
@@ -0,0 +1,47 @@
+package gollback
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestAllContextAbandonsFuncThatIgnoresCancellation(t *testing.T) {
+	g := New(context.Background())
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, errs := g.AllContext(shutdownCtx, func(ctx context.Context) (interface{}, error) {
+		select {}
+	})
+	elapsed := time.Since(start)
+
+	if elapsed > 500*time.Millisecond {
+		t.Fatalf("AllContext blocked for %v instead of returning at the shutdown deadline", elapsed)
+	}
+	if !errors.Is(errs[0], ErrAbandoned) {
+		t.Fatalf("expected ErrAbandoned, got %v", errs[0])
+	}
+}
+
+func TestAllContextReturnsNormallyWhenFuncsFinishInTime(t *testing.T) {
+	g := New(context.Background())
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	rs, errs := g.AllContext(shutdownCtx,
+		func(ctx context.Context) (interface{}, error) {
+			return "ok", nil
+		},
+	)
+	if errs[0] != nil {
+		t.Fatalf("unexpected error: %v", errs[0])
+	}
+	if rs[0] != "ok" {
+		t.Fatalf("expected ok, got %v", rs[0])
+	}
+}